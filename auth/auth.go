@@ -0,0 +1,67 @@
+// Package auth provides authentication and authorization for services.
+package auth
+
+import (
+	"time"
+)
+
+// DefaultAuth is the auth implementation used by this process. It is
+// set by cmd.Cmd based on the --auth flag.
+var DefaultAuth Auth
+
+// Auth is the interface used for authentication and authorization.
+type Auth interface {
+	// Init the auth
+	Init(opts ...Option) error
+	// Options set for auth
+	Options() Options
+	// Generate a new account
+	Generate(id string, opts ...GenerateOption) (*Account, error)
+	// Grant access to a resource
+	Grant(rule *Rule) error
+	// Revoke access to a resource
+	Revoke(rule *Rule) error
+	// Rules returns the rules used to verify requests
+	Rules() ([]*Rule, error)
+	// Verify an account has access to a resource using the rules
+	Verify(acc *Account, res *Resource, opts ...VerifyOption) error
+	// Inspect a token and return the account it belongs to
+	Inspect(token string) (*Account, error)
+	// Token generates an auth Token
+	Token(opts ...TokenOption) (*Token, error)
+	// String returns the name of the implementation
+	String() string
+}
+
+// Account provided by an auth provider.
+type Account struct {
+	// ID of the account e.g. email
+	ID string `json:"id"`
+	// Type of the account e.g. user, service
+	Type string `json:"type"`
+	// Issuer of the account
+	Issuer string `json:"issuer"`
+	// Metadata associated with the account
+	Metadata map[string]string `json:"metadata"`
+	// Scopes the account has access to
+	Scopes []string `json:"scopes"`
+	// Secret used to renew the account's token
+	Secret string `json:"secret"`
+}
+
+// Token is a JWT access token with an optional refresh token.
+type Token struct {
+	// AccessToken used to authenticate
+	AccessToken string `json:"access_token"`
+	// RefreshToken used to renew the access token
+	RefreshToken string `json:"refresh_token"`
+	// Created time of the token
+	Created time.Time `json:"created"`
+	// Expiry of the token
+	Expiry time.Time `json:"expiry"`
+}
+
+// Expired returns a boolean indicating if the token needs refreshing.
+func (t *Token) Expired() bool {
+	return t.Expiry.Unix() < time.Now().Unix()
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "context"
+
+type accountKey struct{}
+
+// NewContext returns a context with the given account attached.
+func NewContext(ctx context.Context, acc *Account) context.Context {
+	return context.WithValue(ctx, accountKey{}, acc)
+}
+
+// FromContext returns the account attached to the context, if any.
+func FromContext(ctx context.Context) (*Account, bool) {
+	acc, ok := ctx.Value(accountKey{}).(*Account)
+	return acc, ok
+}
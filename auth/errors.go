@@ -0,0 +1,10 @@
+package auth
+
+import "errors"
+
+var (
+	// ErrInvalidToken is returned when the token provided is not valid
+	ErrInvalidToken = errors.New("invalid token provided")
+	// ErrForbidden is returned when an account does not have access to a resource
+	ErrForbidden = errors.New("resource forbidden")
+)
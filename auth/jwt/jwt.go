@@ -0,0 +1,136 @@
+// Package jwt is a JWT implementation of the auth interface.
+package jwt
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/micro/go-micro/auth"
+)
+
+// NewAuth returns a new JWT auth provider. Tokens are signed and
+// verified using an RS256 key pair, configured via auth.PublicKey
+// and auth.PrivateKey.
+func NewAuth(opts ...auth.Option) auth.Auth {
+	a := &jwtAuth{}
+	a.Init(opts...)
+	return a
+}
+
+type jwtAuth struct {
+	sync.RWMutex
+
+	options   auth.Options
+	tokenAuth *tokenProvider
+	rules     []*auth.Rule
+}
+
+func (j *jwtAuth) Init(opts ...auth.Option) error {
+	for _, o := range opts {
+		o(&j.options)
+	}
+
+	tp, err := newTokenProvider(j.options.PublicKey, j.options.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	j.Lock()
+	j.tokenAuth = tp
+	j.Unlock()
+	return nil
+}
+
+func (j *jwtAuth) Options() auth.Options {
+	j.RLock()
+	defer j.RUnlock()
+	return j.options
+}
+
+func (j *jwtAuth) Generate(id string, opts ...auth.GenerateOption) (*auth.Account, error) {
+	options := auth.NewGenerateOptions(opts...)
+
+	return &auth.Account{
+		ID:       id,
+		Type:     options.Type,
+		Issuer:   j.options.Id,
+		Metadata: options.Metadata,
+		Scopes:   options.Scopes,
+		Secret:   options.Secret,
+	}, nil
+}
+
+func (j *jwtAuth) Grant(rule *auth.Rule) error {
+	j.Lock()
+	defer j.Unlock()
+
+	for _, r := range j.rules {
+		if r.ID == rule.ID {
+			return fmt.Errorf("a rule with id %v already exists", rule.ID)
+		}
+	}
+
+	j.rules = append(j.rules, rule)
+	return nil
+}
+
+func (j *jwtAuth) Revoke(rule *auth.Rule) error {
+	j.Lock()
+	defer j.Unlock()
+
+	rules := make([]*auth.Rule, 0, len(j.rules))
+	for _, r := range j.rules {
+		if r.ID == rule.ID {
+			continue
+		}
+		rules = append(rules, r)
+	}
+
+	j.rules = rules
+	return nil
+}
+
+func (j *jwtAuth) Rules() ([]*auth.Rule, error) {
+	j.RLock()
+	defer j.RUnlock()
+	return j.rules, nil
+}
+
+func (j *jwtAuth) Verify(acc *auth.Account, res *auth.Resource, opts ...auth.VerifyOption) error {
+	options := auth.NewVerifyOptions(opts...)
+	if len(options.Namespace) > 0 && options.Namespace != res.Namespace {
+		res = &auth.Resource{
+			Namespace: options.Namespace,
+			Type:      res.Type,
+			Name:      res.Name,
+			Endpoint:  res.Endpoint,
+		}
+	}
+
+	j.RLock()
+	rules := j.rules
+	j.RUnlock()
+
+	return verify(rules, acc, res)
+}
+
+func (j *jwtAuth) Inspect(token string) (*auth.Account, error) {
+	j.RLock()
+	tp := j.tokenAuth
+	j.RUnlock()
+
+	return tp.Inspect(token)
+}
+
+func (j *jwtAuth) Token(opts ...auth.TokenOption) (*auth.Token, error) {
+	j.RLock()
+	tp := j.tokenAuth
+	j.RUnlock()
+
+	options := auth.NewTokenOptions(opts...)
+	return tp.Generate(options)
+}
+
+func (j *jwtAuth) String() string {
+	return "jwt"
+}
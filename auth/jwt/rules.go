@@ -0,0 +1,73 @@
+package jwt
+
+import (
+	"sort"
+
+	"github.com/micro/go-micro/auth"
+)
+
+// verify finds the highest priority rule matching both the resource
+// and the account's scope, and applies its access. A request is
+// denied unless a granting rule is found.
+func verify(rules []*auth.Rule, acc *auth.Account, res *auth.Resource) error {
+	matches := filterRules(rules, res)
+	if len(matches) == 0 {
+		return auth.ErrForbidden
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Priority > matches[j].Priority
+	})
+
+	for _, rule := range matches {
+		if !accountMatchesScope(acc, rule.Scope) {
+			continue
+		}
+		if rule.Access == auth.AccessDenied {
+			return auth.ErrForbidden
+		}
+		return nil
+	}
+
+	return auth.ErrForbidden
+}
+
+func filterRules(rules []*auth.Rule, res *auth.Resource) []*auth.Rule {
+	var matches []*auth.Rule
+
+	for _, r := range rules {
+		if r.Resource.Namespace != res.Namespace && r.Resource.Namespace != "*" {
+			continue
+		}
+		if r.Resource.Type != res.Type && r.Resource.Type != "*" {
+			continue
+		}
+		if r.Resource.Name != res.Name && r.Resource.Name != "*" {
+			continue
+		}
+		if len(r.Resource.Endpoint) > 0 && r.Resource.Endpoint != res.Endpoint && r.Resource.Endpoint != "*" {
+			continue
+		}
+		matches = append(matches, r)
+	}
+
+	return matches
+}
+
+func accountMatchesScope(acc *auth.Account, scope string) bool {
+	if len(scope) == 0 {
+		return true
+	}
+	if scope == "*" && acc != nil {
+		return true
+	}
+	if acc == nil {
+		return false
+	}
+	for _, s := range acc.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
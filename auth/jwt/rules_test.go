@@ -0,0 +1,78 @@
+package jwt
+
+import (
+	"testing"
+
+	"github.com/micro/go-micro/auth"
+)
+
+func testResource() *auth.Resource {
+	return &auth.Resource{
+		Namespace: "go.micro",
+		Type:      "service",
+		Name:      "go.micro.srv.foo",
+		Endpoint:  "Foo.Bar",
+	}
+}
+
+func TestVerifyNoRules(t *testing.T) {
+	if err := verify(nil, nil, testResource()); err != auth.ErrForbidden {
+		t.Errorf("expected ErrForbidden with no rules, got %v", err)
+	}
+}
+
+func TestVerifyPublicRule(t *testing.T) {
+	rules := []*auth.Rule{
+		{ID: "public", Resource: &auth.Resource{Namespace: "*", Type: "*", Name: "*"}, Access: auth.AccessGranted},
+	}
+
+	if err := verify(rules, nil, testResource()); err != nil {
+		t.Errorf("expected access to be granted, got %v", err)
+	}
+}
+
+func TestVerifyScopedRule(t *testing.T) {
+	rules := []*auth.Rule{
+		{ID: "admin", Scope: "admin", Resource: testResource(), Access: auth.AccessGranted},
+	}
+
+	if err := verify(rules, nil, testResource()); err != auth.ErrForbidden {
+		t.Errorf("expected account with no scopes to be denied, got %v", err)
+	}
+
+	acc := &auth.Account{ID: "user", Scopes: []string{"admin"}}
+	if err := verify(rules, acc, testResource()); err != nil {
+		t.Errorf("expected account with admin scope to be granted, got %v", err)
+	}
+}
+
+func TestVerifyPriorityWins(t *testing.T) {
+	rules := []*auth.Rule{
+		{ID: "allow-all", Resource: &auth.Resource{Namespace: "*", Type: "*", Name: "*"}, Access: auth.AccessGranted, Priority: 0},
+		{ID: "deny-endpoint", Resource: testResource(), Access: auth.AccessDenied, Priority: 10},
+	}
+
+	if err := verify(rules, nil, testResource()); err != auth.ErrForbidden {
+		t.Errorf("expected the higher priority deny rule to win, got %v", err)
+	}
+}
+
+func TestVerifyEndpointIsOptional(t *testing.T) {
+	rules := []*auth.Rule{
+		{ID: "service-wide", Resource: &auth.Resource{Namespace: "go.micro", Type: "service", Name: "go.micro.srv.foo"}, Access: auth.AccessGranted},
+	}
+
+	if err := verify(rules, nil, testResource()); err != nil {
+		t.Errorf("expected a rule with no endpoint to match any endpoint, got %v", err)
+	}
+}
+
+func TestVerifyNonMatchingResource(t *testing.T) {
+	rules := []*auth.Rule{
+		{ID: "other-service", Resource: &auth.Resource{Namespace: "go.micro", Type: "service", Name: "go.micro.srv.bar"}, Access: auth.AccessGranted},
+	}
+
+	if err := verify(rules, nil, testResource()); err != auth.ErrForbidden {
+		t.Errorf("expected no matching rule to deny access, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/micro/go-micro/auth"
+)
+
+// defaultExpiry is used when no expiry is provided to Generate.
+const defaultExpiry = time.Hour
+
+// claims embedded in the signed token.
+type claims struct {
+	jwt.StandardClaims
+	Type     string            `json:"type"`
+	Scopes   []string          `json:"scopes"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+type tokenProvider struct {
+	publicKey  *rsa.PublicKey
+	privateKey *rsa.PrivateKey
+}
+
+func newTokenProvider(publicKey, privateKey string) (*tokenProvider, error) {
+	tp := new(tokenProvider)
+
+	if len(publicKey) > 0 {
+		pub, err := loadPublicKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		tp.publicKey = pub
+	}
+
+	if len(privateKey) > 0 {
+		priv, err := loadPrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		tp.privateKey = priv
+	}
+
+	return tp, nil
+}
+
+// Generate a signed access token for the given options. The account
+// the token represents must already be known to the caller (e.g. via
+// Auth.Generate); Generate only mints and signs the JWT.
+func (tp *tokenProvider) Generate(opts auth.TokenOptions) (*auth.Token, error) {
+	if tp.privateKey == nil {
+		return nil, auth.ErrInvalidToken
+	}
+
+	expiry := time.Duration(opts.Expiry) * time.Second
+	if expiry <= 0 {
+		expiry = defaultExpiry
+	}
+
+	now := time.Now()
+	c := claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   opts.Id,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(expiry).Unix(),
+		},
+		Type:     opts.Type,
+		Scopes:   opts.Scopes,
+		Metadata: opts.Metadata,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+	access, err := token.SignedString(tp.privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.Token{
+		AccessToken: access,
+		Created:     now,
+		Expiry:      now.Add(expiry),
+	}, nil
+}
+
+// Inspect verifies the token's signature and returns the account it
+// represents.
+func (tp *tokenProvider) Inspect(token string) (*auth.Account, error) {
+	if tp.publicKey == nil {
+		return nil, auth.ErrInvalidToken
+	}
+
+	c := &claims{}
+	_, err := jwt.ParseWithClaims(token, c, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, auth.ErrInvalidToken
+		}
+		return tp.publicKey, nil
+	})
+	if err != nil {
+		return nil, auth.ErrInvalidToken
+	}
+
+	return &auth.Account{
+		ID:       c.Subject,
+		Type:     c.Type,
+		Issuer:   c.Issuer,
+		Scopes:   c.Scopes,
+		Metadata: c.Metadata,
+	}, nil
+}
+
+// loadPublicKey accepts either a PEM-encoded public key, a base64
+// encoding of one, or a path to a file containing one.
+func loadPublicKey(key string) (*rsa.PublicKey, error) {
+	data, err := readKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseRSAPublicKeyFromPEM(data)
+}
+
+// loadPrivateKey accepts either a PEM-encoded private key, a base64
+// encoding of one, or a path to a file containing one.
+func loadPrivateKey(key string) (*rsa.PrivateKey, error) {
+	data, err := readKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseRSAPrivateKeyFromPEM(data)
+}
+
+func readKey(key string) ([]byte, error) {
+	if strings.Contains(key, "-----BEGIN") {
+		return []byte(key), nil
+	}
+
+	if data, err := ioutil.ReadFile(key); err == nil {
+		return data, nil
+	}
+
+	return base64.StdEncoding.DecodeString(key)
+}
@@ -0,0 +1,234 @@
+package auth
+
+type Options struct {
+	// Id is the auth ID used to identify this service
+	Id string
+	// Secret is used alongside Id to authenticate the service
+	Secret string
+	// Namespace the service belongs to, used to scope the resources
+	// it exposes when verifying requests, e.g. go.micro
+	Namespace string
+	// Addrs of the auth service
+	Addrs []string
+	// Token used to authenticate with the auth service, e.g. one
+	// previously issued by Token
+	Token *Token
+	// PublicKey for verifying JWTs, base64 encoded or a path to a file
+	PublicKey string
+	// PrivateKey for signing JWTs, base64 encoded or a path to a file
+	PrivateKey string
+}
+
+type Option func(o *Options)
+
+// Id sets the auth Id used to identify this service.
+func Id(id string) Option {
+	return func(o *Options) {
+		o.Id = id
+	}
+}
+
+// Secret sets the auth Secret used alongside Id.
+func Secret(secret string) Option {
+	return func(o *Options) {
+		o.Secret = secret
+	}
+}
+
+// Namespace sets the namespace the service belongs to.
+func Namespace(ns string) Option {
+	return func(o *Options) {
+		o.Namespace = ns
+	}
+}
+
+// Addrs sets the addresses of the auth service.
+func Addrs(addrs ...string) Option {
+	return func(o *Options) {
+		o.Addrs = addrs
+	}
+}
+
+// ClientToken sets the token used to authenticate with the auth service.
+func ClientToken(token *Token) Option {
+	return func(o *Options) {
+		o.Token = token
+	}
+}
+
+// PublicKey sets the public key used to verify JWTs.
+func PublicKey(key string) Option {
+	return func(o *Options) {
+		o.PublicKey = key
+	}
+}
+
+// PrivateKey sets the private key used to sign JWTs.
+func PrivateKey(key string) Option {
+	return func(o *Options) {
+		o.PrivateKey = key
+	}
+}
+
+// GenerateOptions are used to configure the account created by Generate.
+type GenerateOptions struct {
+	// Metadata associated with the account
+	Metadata map[string]string
+	// Scopes the account has access to
+	Scopes []string
+	// Type of account being generated, e.g. user, service
+	Type string
+	// Secret used to renew the account's token
+	Secret string
+}
+
+type GenerateOption func(o *GenerateOptions)
+
+// Metadata sets the metadata on the generated account.
+func Metadata(md map[string]string) GenerateOption {
+	return func(o *GenerateOptions) {
+		o.Metadata = md
+	}
+}
+
+// Scopes sets the scopes on the generated account.
+func Scopes(scopes ...string) GenerateOption {
+	return func(o *GenerateOptions) {
+		o.Scopes = scopes
+	}
+}
+
+// Type sets the type of the generated account.
+func Type(accType string) GenerateOption {
+	return func(o *GenerateOptions) {
+		o.Type = accType
+	}
+}
+
+// WithSecret sets the secret used to renew the generated account's token.
+func WithSecret(secret string) GenerateOption {
+	return func(o *GenerateOptions) {
+		o.Secret = secret
+	}
+}
+
+func NewGenerateOptions(opts ...GenerateOption) GenerateOptions {
+	var options GenerateOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// VerifyOptions configure how a request is verified against the rules.
+type VerifyOptions struct {
+	// Context namespace to verify against, defaults to the resource's.
+	Namespace string
+}
+
+type VerifyOption func(o *VerifyOptions)
+
+// VerifyNamespace overrides the namespace used to evaluate rules.
+func VerifyNamespace(ns string) VerifyOption {
+	return func(o *VerifyOptions) {
+		o.Namespace = ns
+	}
+}
+
+func NewVerifyOptions(opts ...VerifyOption) VerifyOptions {
+	var options VerifyOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// TokenOptions configure the token returned by Token.
+type TokenOptions struct {
+	// Id of the account the token is issued for
+	Id string
+	// Secret of the account the token is issued for
+	Secret string
+	// Type of the account the token is issued for, e.g. user, service
+	Type string
+	// Scopes the account has access to
+	Scopes []string
+	// Metadata associated with the account
+	Metadata map[string]string
+	// RefreshToken used to renew an access token
+	RefreshToken string
+	// Expiry is the duration the token is valid for
+	Expiry int64
+}
+
+type TokenOption func(o *TokenOptions)
+
+// WithId sets the account Id the token is issued for.
+func WithId(id string) TokenOption {
+	return func(o *TokenOptions) {
+		o.Id = id
+	}
+}
+
+// WithTokenSecret sets the account secret used to authenticate.
+func WithTokenSecret(secret string) TokenOption {
+	return func(o *TokenOptions) {
+		o.Secret = secret
+	}
+}
+
+// WithType sets the type of the account the token is issued for.
+func WithType(accType string) TokenOption {
+	return func(o *TokenOptions) {
+		o.Type = accType
+	}
+}
+
+// WithScopes sets the scopes of the account the token is issued for.
+func WithScopes(scopes ...string) TokenOption {
+	return func(o *TokenOptions) {
+		o.Scopes = scopes
+	}
+}
+
+// WithTokenMetadata sets the metadata of the account the token is
+// issued for.
+func WithTokenMetadata(md map[string]string) TokenOption {
+	return func(o *TokenOptions) {
+		o.Metadata = md
+	}
+}
+
+// WithAccount sets Id, Secret, Type, Scopes and Metadata on the
+// token options from an existing Account in one call.
+func WithAccount(acc *Account) TokenOption {
+	return func(o *TokenOptions) {
+		o.Id = acc.ID
+		o.Secret = acc.Secret
+		o.Type = acc.Type
+		o.Scopes = acc.Scopes
+		o.Metadata = acc.Metadata
+	}
+}
+
+// WithRefreshToken sets the refresh token used to renew an access token.
+func WithRefreshToken(token string) TokenOption {
+	return func(o *TokenOptions) {
+		o.RefreshToken = token
+	}
+}
+
+// WithExpiry sets how long, in seconds, the token should be valid for.
+func WithExpiry(seconds int64) TokenOption {
+	return func(o *TokenOptions) {
+		o.Expiry = seconds
+	}
+}
+
+func NewTokenOptions(opts ...TokenOption) TokenOptions {
+	var options TokenOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
@@ -0,0 +1,51 @@
+package auth
+
+// Access defines whether a rule grants or denies access.
+type Access int
+
+const (
+	// AccessGranted means the rule grants access to the resource.
+	AccessGranted Access = iota
+	// AccessDenied means the rule denies access to the resource.
+	AccessDenied
+)
+
+// Resource is an entity a rule or request pertains to, e.g. a service,
+// topic or an endpoint within a service.
+type Resource struct {
+	// Namespace the resource belongs to, e.g. go.micro
+	Namespace string
+	// Type of resource, e.g. service, topic
+	Type string
+	// Name of the resource, e.g. go.micro.srv.foo
+	Name string
+	// Endpoint within the resource, e.g. Foo.Bar. Optional.
+	Endpoint string
+}
+
+// Rule is used to verify whether a request to use a resource is
+// allowed or denied. Rules are ordered by priority, the highest
+// priority rule matching a resource wins.
+type Rule struct {
+	// ID of the rule, e.g. default
+	ID string
+	// Scope the rule applies to, e.g. an account scope such as admin.
+	// An empty scope is public, a scope of "*" matches any account.
+	Scope string
+	// Resource the rule applies to
+	Resource *Resource
+	// Access granted or denied by the rule
+	Access Access
+	// Priority of the rule, higher priority rules are evaluated first
+	Priority int32
+}
+
+// Rules provides access to the set of rules used to authorize requests.
+type Rules interface {
+	// Grant access to a resource
+	Grant(rule *Rule) error
+	// Revoke access to a resource
+	Revoke(rule *Rule) error
+	// Get the rules currently configured
+	Get() ([]*Rule, error)
+}
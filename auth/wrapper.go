@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/metadata"
+	"github.com/micro/go-micro/server"
+)
+
+// tokenHeader is the metadata key the access token is sent under.
+const tokenHeader = "Micro-Auth-Token"
+
+// NewAuthWrapper wraps a server handler, verifying the inbound token
+// (if any) and checking the caller is authorized to call the endpoint
+// before the request is dispatched. The resulting account, if any, is
+// attached to the context for handlers to read via FromContext.
+func NewAuthWrapper(a Auth) server.HandlerWrapper {
+	return func(h server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			var acc *Account
+
+			if token, ok := metadata.Get(ctx, tokenHeader); ok && len(token) > 0 {
+				a, err := a.Inspect(token)
+				if err != nil {
+					return err
+				}
+				acc = a
+			}
+
+			res := &Resource{
+				Namespace: a.Options().Namespace,
+				Type:      "service",
+				Name:      req.Service(),
+				Endpoint:  req.Endpoint(),
+			}
+
+			if err := a.Verify(acc, res); err != nil {
+				return err
+			}
+
+			if acc != nil {
+				ctx = NewContext(ctx, acc)
+			}
+
+			return h(ctx, req, rsp)
+		}
+	}
+}
+
+// NewAuthClientWrapper wraps a client, attaching the service's own
+// token (the one set via the ClientToken option) to outbound requests
+// so downstream services can authenticate the caller.
+func NewAuthClientWrapper(a Auth) client.Wrapper {
+	return func(c client.Client) client.Client {
+		return &clientWrapper{Client: c, auth: a}
+	}
+}
+
+type clientWrapper struct {
+	client.Client
+	auth Auth
+}
+
+func (c *clientWrapper) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	if ctx2, err := setToken(ctx, c.auth); err == nil {
+		ctx = ctx2
+	}
+	return c.Client.Call(ctx, req, rsp, opts...)
+}
+
+func setToken(ctx context.Context, a Auth) (context.Context, error) {
+	tok := a.Options().Token
+	if tok == nil {
+		return ctx, ErrInvalidToken
+	}
+	return metadata.Set(ctx, tokenHeader, tok.AccessToken), nil
+}
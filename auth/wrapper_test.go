@@ -0,0 +1,68 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/micro/go-micro/auth"
+	"github.com/micro/go-micro/auth/jwt"
+	"github.com/micro/go-micro/server"
+)
+
+type fakeRequest struct {
+	service  string
+	endpoint string
+}
+
+func (r *fakeRequest) Service() string           { return r.service }
+func (r *fakeRequest) Method() string            { return r.endpoint }
+func (r *fakeRequest) Endpoint() string          { return r.endpoint }
+func (r *fakeRequest) ContentType() string       { return "application/json" }
+func (r *fakeRequest) Header() map[string]string { return nil }
+func (r *fakeRequest) Body() interface{}         { return nil }
+func (r *fakeRequest) Read() ([]byte, error)     { return nil, nil }
+func (r *fakeRequest) Stream() bool              { return false }
+
+func noopHandler(ctx context.Context, req server.Request, rsp interface{}) error {
+	return nil
+}
+
+func TestAuthWrapperUsesAuthNamespace(t *testing.T) {
+	a := jwt.NewAuth(auth.Namespace("go.micro"))
+
+	rule := &auth.Rule{
+		ID:       "public",
+		Resource: &auth.Resource{Namespace: "go.micro", Type: "service", Name: "go.micro.srv.foo"},
+		Access:   auth.AccessGranted,
+	}
+	if err := a.Grant(rule); err != nil {
+		t.Fatalf("unexpected error granting rule: %v", err)
+	}
+
+	wrapped := auth.NewAuthWrapper(a)(noopHandler)
+	req := &fakeRequest{service: "go.micro.srv.foo", endpoint: "Foo.Bar"}
+
+	if err := wrapped(context.Background(), req, nil); err != nil {
+		t.Errorf("expected the request to be allowed once the auth's namespace is propagated to the resource, got %v", err)
+	}
+}
+
+func TestAuthWrapperDeniesOtherNamespace(t *testing.T) {
+	a := jwt.NewAuth(auth.Namespace("other"))
+
+	rule := &auth.Rule{
+		ID:       "public",
+		Resource: &auth.Resource{Namespace: "go.micro", Type: "service", Name: "go.micro.srv.foo"},
+		Access:   auth.AccessGranted,
+	}
+	if err := a.Grant(rule); err != nil {
+		t.Fatalf("unexpected error granting rule: %v", err)
+	}
+
+	wrapped := auth.NewAuthWrapper(a)(noopHandler)
+	req := &fakeRequest{service: "go.micro.srv.foo", endpoint: "Foo.Bar"}
+
+	if err := wrapped(context.Background(), req, nil); err != auth.ErrForbidden {
+		t.Errorf("expected a rule scoped to a different namespace to be denied, got %v", err)
+	}
+}
@@ -10,11 +10,27 @@ import (
 	"time"
 
 	"github.com/micro/cli"
+	"github.com/micro/go-micro/auth"
+	"github.com/micro/go-micro/auth/jwt"
 	"github.com/micro/go-micro/broker"
 	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/config"
+	configFile "github.com/micro/go-micro/config/source/file"
+	configService "github.com/micro/go-micro/config/source/service"
+	"github.com/micro/go-micro/debug/profile"
+	"github.com/micro/go-micro/debug/profile/http"
+	"github.com/micro/go-micro/debug/profile/pprof"
+	"github.com/micro/go-micro/debug/trace"
+	traceMemory "github.com/micro/go-micro/debug/trace/memory"
+	"github.com/micro/go-micro/logger"
+	loggerJSON "github.com/micro/go-micro/logger/json"
+	"github.com/micro/go-micro/logger/stdout"
 	"github.com/micro/go-micro/registry"
 	"github.com/micro/go-micro/selector"
 	"github.com/micro/go-micro/server"
+	"github.com/micro/go-micro/store"
+	"github.com/micro/go-micro/store/file"
+	"github.com/micro/go-micro/store/memory"
 	"github.com/micro/go-micro/transport"
 )
 
@@ -110,8 +126,108 @@ var (
 			EnvVar: "MICRO_TRANSPORT_ADDRESS",
 			Usage:  "Comma-separated list of transport addresses",
 		},
+		cli.StringFlag{
+			Name:   "auth",
+			EnvVar: "MICRO_AUTH",
+			Usage:  "Auth for role based access control, e.g. jwt. Disabled unless set",
+		},
+		cli.StringFlag{
+			Name:   "auth_address",
+			EnvVar: "MICRO_AUTH_ADDRESS",
+			Usage:  "Comma-separated list of auth addresses",
+		},
+		cli.StringFlag{
+			Name:   "auth_id",
+			EnvVar: "MICRO_AUTH_ID",
+			Usage:  "Id of the service account to authenticate with",
+		},
+		cli.StringFlag{
+			Name:   "auth_secret",
+			EnvVar: "MICRO_AUTH_SECRET",
+			Usage:  "Secret of the service account to authenticate with",
+		},
+		cli.StringFlag{
+			Name:   "auth_namespace",
+			EnvVar: "MICRO_AUTH_NAMESPACE",
+			Value:  "go.micro",
+			Usage:  "Namespace the service belongs to, used to scope rules when verifying requests",
+		},
+		cli.StringFlag{
+			Name:   "auth_public_key",
+			EnvVar: "MICRO_AUTH_PUBLIC_KEY",
+			Usage:  "Public key, or path to one, used to verify auth tokens. Required for jwt",
+		},
+		cli.StringFlag{
+			Name:   "auth_private_key",
+			EnvVar: "MICRO_AUTH_PRIVATE_KEY",
+			Usage:  "Private key, or path to one, used to sign auth tokens. Required for jwt",
+		},
+		cli.StringFlag{
+			Name:   "store",
+			EnvVar: "MICRO_STORE",
+			Value:  "memory",
+			Usage:  "Store used for key-value storage. memory, file",
+		},
+		cli.StringFlag{
+			Name:   "store_address",
+			EnvVar: "MICRO_STORE_ADDRESS",
+			Usage:  "Comma-separated list of store addresses",
+		},
+		cli.StringFlag{
+			Name:   "store_database",
+			EnvVar: "MICRO_STORE_DATABASE",
+			Usage:  "Database, namespace or bucket used to isolate records. go.micro.srv.example",
+		},
+		cli.StringFlag{
+			Name:   "store_table",
+			EnvVar: "MICRO_STORE_TABLE",
+			Usage:  "Table, partition or prefix used to scope records within a database",
+		},
+		cli.StringFlag{
+			Name:   "config",
+			EnvVar: "MICRO_CONFIG",
+			Usage:  "Config source used for dynamic config. file, service",
+		},
+		cli.StringFlag{
+			Name:   "config_address",
+			EnvVar: "MICRO_CONFIG_ADDRESS",
+			Usage:  "Address of the config source, e.g. a file path or service name",
+		},
+		cli.StringFlag{
+			Name:   "profile",
+			EnvVar: "MICRO_PROFILE",
+			Usage:  "Debug profiler for cpu and memory stats, e.g. pprof, http",
+		},
+		cli.StringFlag{
+			Name:   "profile_address",
+			EnvVar: "MICRO_PROFILE_ADDRESS",
+			Usage:  "Address for the http profiler to listen on, defaults to :6060",
+		},
+		cli.StringFlag{
+			Name:   "tracer",
+			EnvVar: "MICRO_TRACER",
+			Value:  "memory",
+			Usage:  "Tracer for distributed tracing, e.g. memory",
+		},
+		cli.StringFlag{
+			Name:   "logger",
+			EnvVar: "MICRO_LOGGER",
+			Value:  "stdout",
+			Usage:  "Logger for structured logging, e.g. stdout, json",
+		},
+		cli.StringFlag{
+			Name:   "log_level",
+			EnvVar: "MICRO_LOG_LEVEL",
+			Value:  "info",
+			Usage:  "Level of messages to log, e.g. trace, debug, info, warn, error, fatal",
+		},
+		cli.StringFlag{
+			Name:   "log_format",
+			EnvVar: "MICRO_LOG_FORMAT",
+			Usage:  "Deprecated, use --logger instead",
+		},
 
-		// logging flags
+		// logging flags, kept for compatibility with glog-based services
 		cli.BoolFlag{
 			Name:  "logtostderr",
 			Usage: "log to standard error instead of files",
@@ -157,6 +273,64 @@ var (
 	DefaultTransports = map[string]func([]string, ...transport.Option) transport.Transport{
 		"http": transport.NewTransport,
 	}
+
+	DefaultAuths = map[string]func(...auth.Option) auth.Auth{
+		"jwt": jwt.NewAuth,
+	}
+
+	DefaultStores = map[string]func(...store.Option) store.Store{
+		"memory": memory.NewStore,
+		"file":   file.NewStore,
+	}
+
+	DefaultConfigs = map[string]func(...config.Option) config.Source{
+		"file": func(opts ...config.Option) config.Source {
+			options := config.Options{}
+			for _, o := range opts {
+				o(&options)
+			}
+			var addr string
+			if len(options.Addrs) > 0 {
+				addr = options.Addrs[0]
+			}
+			return configFile.NewSource(addr)
+		},
+		"service": func(opts ...config.Option) config.Source {
+			options := config.Options{}
+			for _, o := range opts {
+				o(&options)
+			}
+			addr := "go.micro.config"
+			if len(options.Addrs) > 0 {
+				addr = options.Addrs[0]
+			}
+			return configService.NewSource(addr)
+		},
+	}
+
+	DefaultProfiles = map[string]func(...profile.Option) profile.Profile{
+		"pprof": func(opts ...profile.Option) profile.Profile { return pprof.NewProfile(".") },
+		"http": func(opts ...profile.Option) profile.Profile {
+			options := profile.Options{}
+			for _, o := range opts {
+				o(&options)
+			}
+			addr := ":6060"
+			if len(options.Addrs) > 0 && len(options.Addrs[0]) > 0 {
+				addr = options.Addrs[0]
+			}
+			return http.NewProfile(addr)
+		},
+	}
+
+	DefaultTracers = map[string]func() trace.Tracer{
+		"memory": func() trace.Tracer { return traceMemory.NewTracer(0) },
+	}
+
+	DefaultLoggers = map[string]func(...logger.Option) logger.Logger{
+		"stdout": stdout.NewLogger,
+		"json":   loggerJSON.NewLogger,
+	}
 )
 
 func init() {
@@ -174,6 +348,12 @@ func newCmd(opts ...Option) Cmd {
 		Registries: DefaultRegistries,
 		Selectors:  DefaultSelectors,
 		Transports: DefaultTransports,
+		Auths:      DefaultAuths,
+		Stores:     DefaultStores,
+		Configs:    DefaultConfigs,
+		Profiles:   DefaultProfiles,
+		Tracers:    DefaultTracers,
+		Loggers:    DefaultLoggers,
 	}
 
 	for _, o := range opts {
@@ -191,7 +371,7 @@ func newCmd(opts ...Option) Cmd {
 	cmd.app.Version = cmd.opts.Version
 	cmd.app.Usage = cmd.opts.Description
 	cmd.app.Before = cmd.Before
-	cmd.app.Flags = DefaultFlags
+	cmd.app.Flags = append(DefaultFlags, tlsFlags...)
 	cmd.app.Action = func(c *cli.Context) {}
 
 	if len(options.Version) == 0 {
@@ -210,8 +390,11 @@ func (c *cmd) Options() Options {
 }
 
 func (c *cmd) Before(ctx *cli.Context) error {
-	// Due to logger issues with glog, we need to do this
 	os.Args = os.Args[:1]
+
+	// glog flags are kept only so existing flag.Parse calls in
+	// dependencies don't choke on them; logging itself goes through
+	// logger.DefaultLogger now.
 	flag.Set("logtostderr", fmt.Sprintf("%v", ctx.Bool("logtostderr")))
 	flag.Set("alsologtostderr", fmt.Sprintf("%v", ctx.Bool("alsologtostderr")))
 	flag.Set("stderrthreshold", ctx.String("stderrthreshold"))
@@ -221,12 +404,29 @@ func (c *cmd) Before(ctx *cli.Context) error {
 	flag.Set("v", ctx.String("v"))
 	flag.Parse()
 
+	if l, ok := c.opts.Loggers[ctx.String("logger")]; ok {
+		logger.DefaultLogger = l(logger.WithLevel(logger.ParseLevel(ctx.String("log_level"))))
+	}
+
+	tlsConfig, err := newTLSConfig(ctx)
+	if err != nil {
+		return err
+	}
+
 	if b, ok := c.opts.Brokers[ctx.String("broker")]; ok {
-		broker.DefaultBroker = b(strings.Split(ctx.String("broker_address"), ","))
+		brokerOpts := []broker.Option{}
+		if tlsConfig != nil {
+			brokerOpts = append(brokerOpts, broker.Secure(true), broker.TLSConfig(tlsConfig))
+		}
+		broker.DefaultBroker = b(strings.Split(ctx.String("broker_address"), ","), brokerOpts...)
 	}
 
 	if r, ok := c.opts.Registries[ctx.String("registry")]; ok {
-		registry.DefaultRegistry = r(strings.Split(ctx.String("registry_address"), ","))
+		registryOpts := []registry.Option{}
+		if tlsConfig != nil {
+			registryOpts = append(registryOpts, registry.Secure(true), registry.TLSConfig(tlsConfig))
+		}
+		registry.DefaultRegistry = r(strings.Split(ctx.String("registry_address"), ","), registryOpts...)
 	}
 
 	if s, ok := c.opts.Selectors[ctx.String("selector")]; ok {
@@ -234,7 +434,50 @@ func (c *cmd) Before(ctx *cli.Context) error {
 	}
 
 	if t, ok := c.opts.Transports[ctx.String("transport")]; ok {
-		transport.DefaultTransport = t(strings.Split(ctx.String("transport_address"), ","))
+		transportOpts := []transport.Option{}
+		if tlsConfig != nil {
+			transportOpts = append(transportOpts, transport.Secure(true), transport.TLSConfig(tlsConfig))
+		}
+		transport.DefaultTransport = t(strings.Split(ctx.String("transport_address"), ","), transportOpts...)
+	}
+
+	if a, ok := c.opts.Auths[ctx.String("auth")]; ok {
+		auth.DefaultAuth = a(
+			auth.Id(ctx.String("auth_id")),
+			auth.Secret(ctx.String("auth_secret")),
+			auth.Namespace(ctx.String("auth_namespace")),
+			auth.Addrs(strings.Split(ctx.String("auth_address"), ",")...),
+			auth.PublicKey(ctx.String("auth_public_key")),
+			auth.PrivateKey(ctx.String("auth_private_key")),
+		)
+	}
+
+	if st, ok := c.opts.Stores[ctx.String("store")]; ok {
+		store.DefaultStore = st(
+			store.Nodes(strings.Split(ctx.String("store_address"), ",")...),
+			store.Database(ctx.String("store_database")),
+			store.Table(ctx.String("store_table")),
+		)
+	}
+
+	if cf, ok := c.opts.Configs[ctx.String("config")]; ok {
+		src := cf(config.Addrs(ctx.String("config_address")))
+		if err := config.DefaultConfig.Load(src); err != nil {
+			return err
+		}
+	}
+
+	if name := ctx.String("profile"); len(name) > 0 {
+		if p, ok := c.opts.Profiles[name]; ok {
+			profile.DefaultProfile = p(profile.Addrs(ctx.String("profile_address")))
+			if err := profile.DefaultProfile.Start(c.opts.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if t, ok := c.opts.Tracers[ctx.String("tracer")]; ok {
+		trace.DefaultTracer = t()
 	}
 
 	metadata := make(map[string]string)
@@ -259,6 +502,31 @@ func (c *cmd) Before(ctx *cli.Context) error {
 
 	client.DefaultClient = client.NewClient()
 
+	if auth.DefaultAuth != nil {
+		acc, err := auth.DefaultAuth.Generate(ctx.String("server_id"), auth.Type("service"))
+		if err != nil {
+			return err
+		}
+
+		tok, err := auth.DefaultAuth.Token(auth.WithAccount(acc))
+		if err == nil {
+			auth.DefaultAuth.Init(auth.ClientToken(tok))
+		}
+
+		server.DefaultServer.Init(server.WrapHandler(auth.NewAuthWrapper(auth.DefaultAuth)))
+		client.DefaultClient = auth.NewAuthClientWrapper(auth.DefaultAuth)(client.DefaultClient)
+	}
+
+	if trace.DefaultTracer != nil {
+		server.DefaultServer.Init(server.WrapHandler(trace.NewHandlerWrapper(trace.DefaultTracer)))
+		client.DefaultClient.Init(client.WrapCall(trace.NewCallWrapper(trace.DefaultTracer)))
+	}
+
+	if logger.DefaultLogger != nil {
+		server.DefaultServer.Init(server.WrapHandler(logger.NewHandlerWrapper(logger.DefaultLogger)))
+		client.DefaultClient = logger.NewClientWrapper(logger.DefaultLogger)(client.DefaultClient)
+	}
+
 	return nil
 }
 
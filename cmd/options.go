@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/micro/go-micro/auth"
+	"github.com/micro/go-micro/broker"
+	"github.com/micro/go-micro/config"
+	"github.com/micro/go-micro/debug/profile"
+	"github.com/micro/go-micro/debug/trace"
+	"github.com/micro/go-micro/logger"
+	"github.com/micro/go-micro/registry"
+	"github.com/micro/go-micro/selector"
+	"github.com/micro/go-micro/store"
+	"github.com/micro/go-micro/transport"
+)
+
+type Options struct {
+	// Name of the binary
+	Name string
+	// Description of the binary
+	Description string
+	// Version of the binary
+	Version string
+
+	Brokers    map[string]func([]string, ...broker.Option) broker.Broker
+	Registries map[string]func([]string, ...registry.Option) registry.Registry
+	Selectors  map[string]func(...selector.Option) selector.Selector
+	Transports map[string]func([]string, ...transport.Option) transport.Transport
+	Auths      map[string]func(...auth.Option) auth.Auth
+	Stores     map[string]func(...store.Option) store.Store
+	Configs    map[string]func(...config.Option) config.Source
+	Profiles   map[string]func(...profile.Option) profile.Profile
+	Tracers    map[string]func() trace.Tracer
+	Loggers    map[string]func(...logger.Option) logger.Logger
+}
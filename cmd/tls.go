@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/micro/cli"
+)
+
+var tlsFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:   "tls_cert_file",
+		EnvVar: "MICRO_TLS_CERT_FILE",
+		Usage:  "Path to the TLS certificate file",
+	},
+	cli.StringFlag{
+		Name:   "tls_key_file",
+		EnvVar: "MICRO_TLS_KEY_FILE",
+		Usage:  "Path to the TLS key file",
+	},
+	cli.StringFlag{
+		Name:   "tls_client_ca_file",
+		EnvVar: "MICRO_TLS_CLIENT_CA_FILE",
+		Usage:  "Path to the CA used to verify client certificates, enables mTLS",
+	},
+	cli.BoolFlag{
+		Name:   "tls_insecure_skip_verify",
+		EnvVar: "MICRO_TLS_INSECURE_SKIP_VERIFY",
+		Usage:  "Skip verification of the certificate presented by the other side of a connection. Insecure, for testing only",
+	},
+}
+
+// newTLSConfig builds a *tls.Config from the tls_* flags. It returns
+// nil, nil when no certificate has been configured, so callers can
+// leave transports/brokers/registries on their plaintext defaults.
+func newTLSConfig(ctx *cli.Context) (*tls.Config, error) {
+	certFile := ctx.String("tls_cert_file")
+	keyFile := ctx.String("tls_key_file")
+
+	if len(certFile) == 0 || len(keyFile) == 0 {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tls certificate: %v", err)
+	}
+
+	config := &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: ctx.Bool("tls_insecure_skip_verify"),
+	}
+
+	if caFile := ctx.String("tls_client_ca_file"); len(caFile) > 0 {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls client ca: %v", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse tls client ca: %v", caFile)
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
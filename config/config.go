@@ -0,0 +1,58 @@
+// Package config is an interface for dynamic configuration.
+package config
+
+import (
+	"github.com/micro/go-micro/config/source"
+)
+
+// Source is config as read from somewhere, e.g. a file on disk, a
+// remote config service, or the environment.
+type Source = source.Source
+
+// Config is an interface abstraction for dynamic configuration, it
+// loads and merges config from one or more Sources and allows values
+// to be read and watched for changes.
+type Config interface {
+	// Init the config
+	Init(opts ...Option) error
+	// Options returns the options used to configure Config
+	Options() Options
+	// Load and merge config from the given sources, environment
+	// variables always take precedence over a loaded Source.
+	Load(sources ...Source) error
+	// Get a value at the given path, e.g. Get("database", "address")
+	Get(path ...string) Value
+	// Set a value at the given path
+	Set(val interface{}, path ...string) error
+	// Watch registers fn to be called whenever reloading the sources
+	// changes the value at path. An empty path watches the entire config.
+	Watch(fn func(Value), path ...string) error
+	// String returns the name of the implementation
+	String() string
+}
+
+// DefaultConfig is the config used by the Get/Set/Load/Watch package
+// functions. cmd.Cmd loads the source selected by the --config flag
+// into it.
+var DefaultConfig Config = newConfig()
+
+// Load merges config from the given sources into DefaultConfig.
+func Load(sources ...Source) error {
+	return DefaultConfig.Load(sources...)
+}
+
+// Get returns the value at path from DefaultConfig.
+func Get(path ...string) Value {
+	return DefaultConfig.Get(path...)
+}
+
+// Set sets the value at path in DefaultConfig.
+func Set(val interface{}, path ...string) error {
+	return DefaultConfig.Set(val, path...)
+}
+
+// Watch registers fn to be called whenever reloading the sources
+// changes the value at path in DefaultConfig.
+func Watch(fn func(Value), path ...string) error {
+	return DefaultConfig.Watch(fn, path...)
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"github.com/micro/go-micro/config/source"
+	"github.com/micro/go-micro/config/source/env"
+)
+
+type watcher struct {
+	path []string
+	fn   func(Value)
+}
+
+type defaultConfig struct {
+	sync.RWMutex
+
+	options Options
+	sources []Source
+	data    map[string]interface{}
+	watched []watcher
+	stop    chan bool
+}
+
+func newConfig(opts ...Option) Config {
+	c := &defaultConfig{
+		data: make(map[string]interface{}),
+		stop: make(chan bool),
+	}
+	c.Init(opts...)
+	return c
+}
+
+func (c *defaultConfig) Init(opts ...Option) error {
+	for _, o := range opts {
+		o(&c.options)
+	}
+	return nil
+}
+
+func (c *defaultConfig) Options() Options {
+	return c.options
+}
+
+func (c *defaultConfig) Load(sources ...Source) error {
+	c.Lock()
+	c.sources = append(c.sources, sources...)
+	c.Unlock()
+
+	if err := c.reload(); err != nil {
+		return err
+	}
+
+	for _, s := range sources {
+		w, err := s.Watch()
+		if err != nil {
+			// not every source supports watching, that's fine
+			continue
+		}
+		go c.watch(w)
+	}
+
+	return nil
+}
+
+// reload reads every source in order and merges the result, with
+// environment variables always layered on top so they win.
+func (c *defaultConfig) reload() error {
+	merged := make(map[string]interface{})
+
+	c.RLock()
+	sources := append([]Source{}, c.sources...)
+	c.RUnlock()
+
+	for _, s := range sources {
+		cs, err := s.Read()
+		if err != nil {
+			return err
+		}
+
+		var data map[string]interface{}
+		if err := json.Unmarshal(cs.Data, &data); err != nil {
+			continue
+		}
+		mergeMap(merged, data)
+	}
+
+	envCs, err := env.NewSource().Read()
+	if err == nil {
+		var data map[string]interface{}
+		if err := json.Unmarshal(envCs.Data, &data); err == nil {
+			mergeMap(merged, data)
+		}
+	}
+
+	c.RLock()
+	watched := append([]watcher{}, c.watched...)
+	c.RUnlock()
+
+	prev := make([][]byte, len(watched))
+	for i, w := range watched {
+		prev[i] = c.Get(w.path...).Bytes()
+	}
+
+	c.Lock()
+	c.data = merged
+	c.Unlock()
+
+	for i, w := range watched {
+		if v := c.Get(w.path...); !bytes.Equal(prev[i], v.Bytes()) {
+			w.fn(v)
+		}
+	}
+
+	return nil
+}
+
+func (c *defaultConfig) watch(w source.Watcher) {
+	for {
+		_, err := w.Next()
+		if err != nil {
+			return
+		}
+		c.reload()
+	}
+}
+
+func (c *defaultConfig) Get(path ...string) Value {
+	c.RLock()
+	defer c.RUnlock()
+
+	var node interface{} = c.data
+	for _, p := range path {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return newValue(nil)
+		}
+		node, ok = m[p]
+		if !ok {
+			return newValue(nil)
+		}
+	}
+
+	data, err := json.Marshal(node)
+	if err != nil {
+		return newValue(nil)
+	}
+	return newValue(data)
+}
+
+func (c *defaultConfig) Set(val interface{}, path ...string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if len(path) == 0 {
+		m, ok := val.(map[string]interface{})
+		if !ok {
+			return errNotAMap
+		}
+		c.data = m
+		return nil
+	}
+
+	node := c.data
+	for _, p := range path[:len(path)-1] {
+		child, ok := node[p].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			node[p] = child
+		}
+		node = child
+	}
+	node[path[len(path)-1]] = val
+
+	return nil
+}
+
+func (c *defaultConfig) Watch(fn func(Value), path ...string) error {
+	c.Lock()
+	c.watched = append(c.watched, watcher{path: path, fn: fn})
+	c.Unlock()
+	return nil
+}
+
+func (c *defaultConfig) String() string {
+	return "config"
+}
+
+func mergeMap(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if srcChild, ok := v.(map[string]interface{}); ok {
+			if dstChild, ok := dst[k].(map[string]interface{}); ok {
+				mergeMap(dstChild, srcChild)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/micro/go-micro/config/source"
+)
+
+// staticSource is a minimal source.Source backed by a fixed JSON blob,
+// used to exercise merge/precedence without touching the filesystem.
+type staticSource struct {
+	data string
+}
+
+func (s *staticSource) Read() (*source.ChangeSet, error) {
+	return &source.ChangeSet{Data: []byte(s.data), Format: "json", Source: s.String()}, nil
+}
+
+func (s *staticSource) Watch() (source.Watcher, error) {
+	return nil, source.ErrWatchNotSupported
+}
+
+func (s *staticSource) String() string {
+	return "static"
+}
+
+func TestLoadMergesSourcesInOrder(t *testing.T) {
+	c := newConfig()
+
+	first := &staticSource{data: `{"database": {"address": "first", "timeout": 1}}`}
+	second := &staticSource{data: `{"database": {"address": "second"}}`}
+
+	if err := c.Load(first, second); err != nil {
+		t.Fatalf("unexpected error loading sources: %v", err)
+	}
+
+	if addr := c.Get("database", "address").String(""); addr != "second" {
+		t.Errorf("expected the later source to win on conflicting keys, got %q", addr)
+	}
+	if timeout := c.Get("database", "timeout").Int(0); timeout != 1 {
+		t.Errorf("expected keys unique to the earlier source to survive the merge, got %d", timeout)
+	}
+}
+
+func TestLoadEnvOverridesSources(t *testing.T) {
+	os.Setenv("MICRO_CONFIG_DATABASE_ADDRESS", "from-env")
+	defer os.Unsetenv("MICRO_CONFIG_DATABASE_ADDRESS")
+
+	c := newConfig()
+	src := &staticSource{data: `{"database": {"address": "from-source"}}`}
+
+	if err := c.Load(src); err != nil {
+		t.Fatalf("unexpected error loading source: %v", err)
+	}
+
+	if addr := c.Get("database", "address").String(""); addr != "from-env" {
+		t.Errorf("expected environment variables to always win, got %q", addr)
+	}
+}
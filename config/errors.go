@@ -0,0 +1,7 @@
+package config
+
+import "errors"
+
+// errNotAMap is returned by Set when setting the root of the config
+// to a value that isn't a map.
+var errNotAMap = errors.New("config: root value must be a map[string]interface{}")
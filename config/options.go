@@ -0,0 +1,15 @@
+package config
+
+type Options struct {
+	// Addrs of the config source, e.g. a file path or service address
+	Addrs []string
+}
+
+type Option func(o *Options)
+
+// Addrs sets the addresses of the config source.
+func Addrs(addrs ...string) Option {
+	return func(o *Options) {
+		o.Addrs = addrs
+	}
+}
@@ -0,0 +1,80 @@
+// Package env reads config from environment variables prefixed with
+// MICRO_CONFIG_, e.g. MICRO_CONFIG_DATABASE_ADDRESS becomes
+// database.address.
+package env
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/config/source"
+)
+
+const prefix = "MICRO_CONFIG_"
+
+// reserved lists the MICRO_CONFIG_* env vars owned by cmd itself, e.g.
+// to select and locate the config source. They are not config values
+// and must not be folded into the data this source reads.
+var reserved = map[string]bool{
+	"MICRO_CONFIG":         true,
+	"MICRO_CONFIG_ADDRESS": true,
+}
+
+// NewSource returns a config source backed by environment variables.
+func NewSource() source.Source {
+	return new(envSource)
+}
+
+type envSource struct{}
+
+func (e *envSource) Read() (*source.ChangeSet, error) {
+	data := make(map[string]interface{})
+
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], prefix) || reserved[parts[0]] {
+			continue
+		}
+
+		key := strings.TrimPrefix(parts[0], prefix)
+		path := strings.Split(strings.ToLower(key), "_")
+		setPath(data, path, parts[1])
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &source.ChangeSet{
+		Data:      b,
+		Format:    "json",
+		Source:    e.String(),
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// Watch is not supported by the env source, there is nothing to
+// subscribe to; environment variables are re-read on every reload.
+func (e *envSource) Watch() (source.Watcher, error) {
+	return nil, source.ErrWatchNotSupported
+}
+
+func (e *envSource) String() string {
+	return "env"
+}
+
+func setPath(data map[string]interface{}, path []string, val string) {
+	node := data
+	for _, p := range path[:len(path)-1] {
+		child, ok := node[p].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			node[p] = child
+		}
+		node = child
+	}
+	node[path[len(path)-1]] = val
+}
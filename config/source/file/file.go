@@ -0,0 +1,113 @@
+// Package file reads config from a file on disk, in JSON or YAML.
+package file
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/micro/go-micro/config/source"
+)
+
+// NewSource returns a config source that reads from the file at path.
+func NewSource(path string) source.Source {
+	return &fileSource{path: path}
+}
+
+type fileSource struct {
+	path string
+}
+
+func (f *fileSource) Read() (*source.ChangeSet, error) {
+	raw, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	data := raw
+	if ext := strings.ToLower(filepath.Ext(f.path)); ext == ".yml" || ext == ".yaml" {
+		data, err = yaml.YAMLToJSON(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &source.ChangeSet{
+		Data:      data,
+		Checksum:  fmt.Sprintf("%x", md5.Sum(data)),
+		Format:    "json",
+		Source:    f.String(),
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// Watch polls the file for changes, as most filesystems give us no
+// portable way of subscribing to a single file's mtime.
+func (f *fileSource) Watch() (source.Watcher, error) {
+	cs, err := f.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &watcher{
+		path:     f.path,
+		src:      f,
+		checksum: cs.Checksum,
+		updates:  make(chan *source.ChangeSet, 1),
+		stop:     make(chan bool),
+	}
+	go w.run()
+	return w, nil
+}
+
+func (f *fileSource) String() string {
+	return "file"
+}
+
+type watcher struct {
+	path     string
+	src      *fileSource
+	checksum string
+	updates  chan *source.ChangeSet
+	stop     chan bool
+}
+
+func (w *watcher) run() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			cs, err := w.src.Read()
+			if err != nil {
+				continue
+			}
+			if cs.Checksum == w.checksum {
+				continue
+			}
+			w.checksum = cs.Checksum
+			w.updates <- cs
+		}
+	}
+}
+
+func (w *watcher) Next() (*source.ChangeSet, error) {
+	select {
+	case cs := <-w.updates:
+		return cs, nil
+	case <-w.stop:
+		return nil, source.ErrWatcherStopped
+	}
+}
+
+func (w *watcher) Stop() error {
+	close(w.stop)
+	return nil
+}
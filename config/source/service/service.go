@@ -0,0 +1,58 @@
+// Package service reads config from a remote go.micro.config service
+// over RPC.
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/config/source"
+)
+
+// NewSource returns a config source that reads from the config
+// service registered as name, e.g. go.micro.config.
+func NewSource(name string) source.Source {
+	return &serviceSource{name: name}
+}
+
+type serviceSource struct {
+	name string
+}
+
+type readRequest struct{}
+
+type readResponse struct {
+	Data   string `json:"data"`
+	Format string `json:"format"`
+}
+
+func (s *serviceSource) Read() (*source.ChangeSet, error) {
+	// client.DefaultClient is resolved here rather than captured at
+	// construction time, since cmd.Before sets it up (auth, tracing,
+	// ...) after config sources are created.
+	c := client.DefaultClient
+	req := c.NewRequest(s.name, "Config.Read", &readRequest{})
+	rsp := &readResponse{}
+
+	if err := c.Call(context.Background(), req, rsp); err != nil {
+		return nil, err
+	}
+
+	return &source.ChangeSet{
+		Data:      []byte(rsp.Data),
+		Format:    rsp.Format,
+		Source:    s.String(),
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// Watch is not yet supported by the service source; callers should
+// poll Read instead.
+func (s *serviceSource) Watch() (source.Watcher, error) {
+	return nil, source.ErrWatchNotSupported
+}
+
+func (s *serviceSource) String() string {
+	return "service"
+}
@@ -0,0 +1,48 @@
+// Package source is the interface for sources of config, e.g. a file
+// on disk or a remote config service.
+package source
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrWatchNotSupported is returned by Source implementations that
+	// have no meaningful way of watching for changes.
+	ErrWatchNotSupported = errors.New("source: watch not supported")
+	// ErrWatcherStopped is returned by Next once Stop has been called.
+	ErrWatcherStopped = errors.New("source: watcher stopped")
+)
+
+// Source provides config to load, and optionally watch for changes.
+type Source interface {
+	// Read the source and return a ChangeSet
+	Read() (*ChangeSet, error)
+	// Watch the source for changes
+	Watch() (Watcher, error)
+	// String returns the name of the implementation
+	String() string
+}
+
+// Watcher watches a Source for changes.
+type Watcher interface {
+	// Next blocks until an update is available or an error occurs
+	Next() (*ChangeSet, error)
+	// Stop the watcher
+	Stop() error
+}
+
+// ChangeSet is a raw snapshot of config as read from a Source.
+type ChangeSet struct {
+	// Data is the raw encoded config
+	Data []byte
+	// Checksum of the Data
+	Checksum string
+	// Format the Data is encoded in, e.g. json, yaml
+	Format string
+	// Source the ChangeSet came from
+	Source string
+	// Timestamp the ChangeSet was read at
+	Timestamp time.Time
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Value is a value read from Config. Accessors return def when the
+// value is absent or cannot be converted to the requested type.
+type Value interface {
+	Bool(def bool) bool
+	Int(def int) int
+	Float64(def float64) float64
+	String(def string) string
+	Duration(def time.Duration) time.Duration
+	StringSlice(def []string) []string
+	StringMap(def map[string]string) map[string]string
+	Scan(v interface{}) error
+	Bytes() []byte
+}
+
+// value is the default Value implementation, backed by raw JSON.
+type value struct {
+	raw []byte
+}
+
+func newValue(raw []byte) Value {
+	if raw == nil {
+		raw = []byte("null")
+	}
+	return &value{raw: raw}
+}
+
+func (v *value) Bytes() []byte {
+	return v.raw
+}
+
+func (v *value) Scan(val interface{}) error {
+	return json.Unmarshal(v.raw, val)
+}
+
+func (v *value) Bool(def bool) bool {
+	var b bool
+	if err := v.Scan(&b); err != nil {
+		return def
+	}
+	return b
+}
+
+func (v *value) Int(def int) int {
+	var i int
+	if err := v.Scan(&i); err != nil {
+		return def
+	}
+	return i
+}
+
+func (v *value) Float64(def float64) float64 {
+	var f float64
+	if err := v.Scan(&f); err != nil {
+		return def
+	}
+	return f
+}
+
+func (v *value) String(def string) string {
+	var s string
+	if err := v.Scan(&s); err != nil {
+		return def
+	}
+	return s
+}
+
+func (v *value) Duration(def time.Duration) time.Duration {
+	var s string
+	if err := v.Scan(&s); err != nil {
+		return def
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+func (v *value) StringSlice(def []string) []string {
+	var s []string
+	if err := v.Scan(&s); err != nil {
+		return def
+	}
+	return s
+}
+
+func (v *value) StringMap(def map[string]string) map[string]string {
+	var m map[string]string
+	if err := v.Scan(&m); err != nil {
+		return def
+	}
+	return m
+}
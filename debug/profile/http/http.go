@@ -0,0 +1,55 @@
+// Package http serves runtime profiling data via net/http/pprof.
+package http
+
+import (
+	"net/http"
+	// registers the /debug/pprof handlers on http.DefaultServeMux
+	_ "net/http/pprof"
+	"sync"
+
+	"github.com/micro/go-micro/debug/profile"
+)
+
+// NewProfile returns a profiler that serves /debug/pprof on addr
+// until Stop is called.
+func NewProfile(addr string) profile.Profile {
+	return &httpProfile{addr: addr}
+}
+
+type httpProfile struct {
+	sync.Mutex
+
+	addr   string
+	server *http.Server
+}
+
+func (h *httpProfile) Start(name string) error {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.server != nil {
+		return nil
+	}
+
+	h.server = &http.Server{Addr: h.addr}
+	go h.server.ListenAndServe()
+
+	return nil
+}
+
+func (h *httpProfile) Stop() error {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.server == nil {
+		return nil
+	}
+
+	err := h.server.Close()
+	h.server = nil
+	return err
+}
+
+func (h *httpProfile) String() string {
+	return "http"
+}
@@ -0,0 +1,16 @@
+package profile
+
+type Options struct {
+	// Addrs the profiler should use, e.g. the address an HTTP
+	// profiler listens on
+	Addrs []string
+}
+
+type Option func(o *Options)
+
+// Addrs sets the addresses used by the profiler.
+func Addrs(addrs ...string) Option {
+	return func(o *Options) {
+		o.Addrs = addrs
+	}
+}
@@ -0,0 +1,76 @@
+// Package pprof writes CPU and heap profiles to disk using the
+// standard library's runtime/pprof.
+package pprof
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	rpprof "runtime/pprof"
+	"sync"
+
+	"github.com/micro/go-micro/debug/profile"
+)
+
+// NewProfile returns a profiler that writes CPU and heap profiles to
+// dir, defaulting to the current directory.
+func NewProfile(dir string) profile.Profile {
+	return &pprofProfile{dir: dir}
+}
+
+type pprofProfile struct {
+	sync.Mutex
+
+	dir     string
+	name    string
+	cpuFile *os.File
+}
+
+func (p *pprofProfile) Start(name string) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.cpuFile != nil {
+		return fmt.Errorf("profile already started")
+	}
+
+	p.name = name
+
+	f, err := os.Create(filepath.Join(p.dir, name+".cpu.pprof"))
+	if err != nil {
+		return err
+	}
+
+	if err := rpprof.StartCPUProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	p.cpuFile = f
+	return nil
+}
+
+func (p *pprofProfile) Stop() error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.cpuFile == nil {
+		return nil
+	}
+
+	rpprof.StopCPUProfile()
+	p.cpuFile.Close()
+	p.cpuFile = nil
+
+	f, err := os.Create(filepath.Join(p.dir, p.name+".heap.pprof"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return rpprof.WriteHeapProfile(f)
+}
+
+func (p *pprofProfile) String() string {
+	return "pprof"
+}
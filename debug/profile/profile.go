@@ -0,0 +1,17 @@
+// Package profile is an interface for profiling a running process.
+package profile
+
+// Profile is the interface for a profiler, e.g. pprof.
+type Profile interface {
+	// Start the profiler, using name to identify its output, e.g. a
+	// file prefix or log line.
+	Start(name string) error
+	// Stop the profiler, flushing any buffered output.
+	Stop() error
+	// String returns the name of the implementation.
+	String() string
+}
+
+// DefaultProfile is the profile used by this process, if any. It is
+// set by cmd.Cmd based on the --profile flag.
+var DefaultProfile Profile
@@ -0,0 +1,95 @@
+// Package memory is an in-memory ring-buffer trace.Tracer
+// implementation.
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/micro/go-micro/debug/trace"
+)
+
+// defaultSize is the number of spans retained before older ones are
+// overwritten.
+const defaultSize = 64
+
+// NewTracer returns an in-memory tracer that retains the most recent
+// size spans, overwriting the oldest once full. A size of 0 uses
+// defaultSize.
+func NewTracer(size int) trace.Tracer {
+	if size <= 0 {
+		size = defaultSize
+	}
+	return &memoryTracer{ring: make([]*trace.Span, size)}
+}
+
+type memoryTracer struct {
+	sync.Mutex
+
+	counter uint64
+	ring    []*trace.Span
+	next    int
+	count   int
+}
+
+func (m *memoryTracer) Start(ctx context.Context, name string) (context.Context, *trace.Span) {
+	id := atomic.AddUint64(&m.counter, 1)
+
+	traceID := strconv.FormatUint(id, 10)
+	if parent, ok := trace.SpanFromContext(ctx); ok {
+		traceID = parent.Trace
+	}
+
+	span := &trace.Span{
+		Id:       strconv.FormatUint(id, 10),
+		Trace:    traceID,
+		Name:     name,
+		Started:  time.Now(),
+		Metadata: make(map[string]string),
+	}
+
+	return trace.NewContext(ctx, span), span
+}
+
+func (m *memoryTracer) Finish(span *trace.Span) error {
+	span.Duration = time.Since(span.Started)
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.ring[m.next] = span
+	m.next = (m.next + 1) % len(m.ring)
+	if m.count < len(m.ring) {
+		m.count++
+	}
+
+	return nil
+}
+
+func (m *memoryTracer) Read(opts ...trace.ReadOption) ([]*trace.Span, error) {
+	options := trace.NewReadOptions(opts...)
+
+	m.Lock()
+	defer m.Unlock()
+
+	// walk the ring oldest-first
+	start := (m.next - m.count + len(m.ring)) % len(m.ring)
+
+	var spans []*trace.Span
+	for i := 0; i < m.count; i++ {
+		s := m.ring[(start+i)%len(m.ring)]
+		if len(options.Trace) > 0 && s.Trace != options.Trace {
+			continue
+		}
+		spans = append(spans, s)
+	}
+
+	if options.Limit > 0 && int(options.Limit) < len(spans) {
+		spans = spans[len(spans)-int(options.Limit):]
+	}
+
+	return spans, nil
+}
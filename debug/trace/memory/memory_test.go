@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/micro/go-micro/debug/trace"
+)
+
+func TestStartFinishRead(t *testing.T) {
+	tr := NewTracer(0)
+
+	_, span := tr.Start(context.Background(), "Foo.Bar")
+	if err := tr.Finish(span); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans, err := tr.Read()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(spans) != 1 || spans[0].Name != "Foo.Bar" {
+		t.Fatalf("expected the finished span to be readable, got %+v", spans)
+	}
+}
+
+func TestChildSpanSharesTrace(t *testing.T) {
+	tr := NewTracer(0)
+
+	ctx, parent := tr.Start(context.Background(), "Foo.Bar")
+	_, child := tr.Start(ctx, "Foo.Baz")
+
+	if child.Trace != parent.Trace {
+		t.Errorf("expected child span to share its parent's trace id, got parent=%q child=%q", parent.Trace, child.Trace)
+	}
+}
+
+func TestRingBufferWraparound(t *testing.T) {
+	tr := NewTracer(2)
+
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "Foo.Bar")
+		tr.Finish(span)
+	}
+
+	spans, err := tr.Read()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(spans) != 2 {
+		t.Fatalf("expected ring of size 2 to retain only 2 spans, got %d", len(spans))
+	}
+	// the first span started should have been overwritten by the third
+	if spans[0].Id == "1" {
+		t.Errorf("expected the oldest span to have been evicted, got %+v", spans)
+	}
+}
+
+func TestReadFilterByTrace(t *testing.T) {
+	tr := NewTracer(0)
+
+	ctx, first := tr.Start(context.Background(), "Foo.Bar")
+	_, second := tr.Start(ctx, "Foo.Baz")
+	_, other := tr.Start(context.Background(), "Foo.Qux")
+	tr.Finish(first)
+	tr.Finish(second)
+	tr.Finish(other)
+
+	spans, err := tr.Read(trace.ReadTrace(first.Trace))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(spans) != 2 {
+		t.Fatalf("expected only spans sharing the trace id, got %d", len(spans))
+	}
+}
+
+func TestReadLimit(t *testing.T) {
+	tr := NewTracer(0)
+
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "Foo.Bar")
+		tr.Finish(span)
+	}
+
+	spans, err := tr.Read(trace.ReadLimit(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(spans) != 2 {
+		t.Fatalf("expected limit to cap the number of spans returned, got %d", len(spans))
+	}
+}
@@ -0,0 +1,33 @@
+package trace
+
+// ReadOptions adjust the behaviour of Tracer.Read.
+type ReadOptions struct {
+	// Trace restricts results to a single trace id
+	Trace string
+	// Limit caps the number of spans returned, keeping the most recent
+	Limit uint
+}
+
+type ReadOption func(o *ReadOptions)
+
+// ReadTrace restricts Read to spans belonging to the given trace id.
+func ReadTrace(trace string) ReadOption {
+	return func(o *ReadOptions) {
+		o.Trace = trace
+	}
+}
+
+// ReadLimit caps the number of spans returned, keeping the most recent.
+func ReadLimit(limit uint) ReadOption {
+	return func(o *ReadOptions) {
+		o.Limit = limit
+	}
+}
+
+func NewReadOptions(opts ...ReadOption) ReadOptions {
+	var options ReadOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
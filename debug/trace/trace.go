@@ -0,0 +1,53 @@
+// Package trace provides call tracing for debugging a running
+// service.
+package trace
+
+import (
+	"context"
+	"time"
+)
+
+// Tracer records and retrieves trace Spans.
+type Tracer interface {
+	// Start a new Span with the given name, returning a context
+	// carrying the Span so spans started from it share its trace.
+	Start(ctx context.Context, name string) (context.Context, *Span)
+	// Finish the Span, recording its duration.
+	Finish(span *Span) error
+	// Read returns the spans matching the given options.
+	Read(opts ...ReadOption) ([]*Span, error)
+}
+
+// Span is a single traced unit of work, e.g. one RPC.
+type Span struct {
+	// Id of the span
+	Id string
+	// Trace the span belongs to, shared by related spans
+	Trace string
+	// Name of the span, e.g. the method called
+	Name string
+	// Started is when the span began
+	Started time.Time
+	// Duration the span took to complete
+	Duration time.Duration
+	// Metadata associated with the span
+	Metadata map[string]string
+}
+
+type spanKey struct{}
+
+// NewContext returns a context carrying span, for use by Tracer
+// implementations when building the context returned from Start.
+func NewContext(ctx context.Context, s *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, s)
+}
+
+// SpanFromContext returns the Span started for ctx, if any.
+func SpanFromContext(ctx context.Context) (*Span, bool) {
+	s, ok := ctx.Value(spanKey{}).(*Span)
+	return s, ok
+}
+
+// DefaultTracer is the tracer used by this process. It is set by
+// cmd.Cmd based on the --tracer flag.
+var DefaultTracer Tracer
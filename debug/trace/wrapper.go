@@ -0,0 +1,41 @@
+package trace
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/registry"
+	"github.com/micro/go-micro/server"
+)
+
+// NewHandlerWrapper records a span, via t, for every request the
+// server handles.
+func NewHandlerWrapper(t Tracer) server.HandlerWrapper {
+	return func(h server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			newCtx, span := t.Start(ctx, req.Service()+"."+req.Endpoint())
+			err := h(newCtx, req, rsp)
+			if err != nil {
+				span.Metadata["error"] = err.Error()
+			}
+			t.Finish(span)
+			return err
+		}
+	}
+}
+
+// NewCallWrapper records a span, via t, for every request the client
+// makes.
+func NewCallWrapper(t Tracer) client.CallWrapper {
+	return func(cf client.CallFunc) client.CallFunc {
+		return func(ctx context.Context, node *registry.Node, req client.Request, rsp interface{}, opts client.CallOptions) error {
+			newCtx, span := t.Start(ctx, req.Service()+"."+req.Endpoint())
+			err := cf(newCtx, node, req, rsp, opts)
+			if err != nil {
+				span.Metadata["error"] = err.Error()
+			}
+			t.Finish(span)
+			return err
+		}
+	}
+}
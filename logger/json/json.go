@@ -0,0 +1,99 @@
+// Package json is a JSON-formatted logger.Logger that writes to an
+// io.Writer, os.Stdout by default.
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/micro/go-micro/logger"
+)
+
+// NewLogger returns a JSON-formatted logger.
+func NewLogger(opts ...logger.Option) logger.Logger {
+	l := &jsonLogger{}
+	l.Init(opts...)
+	return l
+}
+
+type jsonLogger struct {
+	sync.Mutex
+	options logger.Options
+}
+
+func (l *jsonLogger) Init(opts ...logger.Option) error {
+	for _, o := range opts {
+		o(&l.options)
+	}
+	if l.options.Out == nil {
+		l.options.Out = os.Stdout
+	}
+	return nil
+}
+
+func (l *jsonLogger) Options() logger.Options {
+	return l.options
+}
+
+type entry struct {
+	Time   string                 `json:"time"`
+	Level  string                 `json:"level"`
+	Msg    string                 `json:"msg"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+func (l *jsonLogger) Log(level logger.Level, args ...interface{}) {
+	l.write(level, fmt.Sprint(args...))
+}
+
+func (l *jsonLogger) Logf(level logger.Level, format string, args ...interface{}) {
+	l.write(level, fmt.Sprintf(format, args...))
+}
+
+func (l *jsonLogger) Fields(fields map[string]interface{}) logger.Logger {
+	merged := make(map[string]interface{}, len(l.options.Fields)+len(fields))
+	for k, v := range l.options.Fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return &jsonLogger{options: logger.Options{
+		Level:  l.options.Level,
+		Out:    l.options.Out,
+		Fields: merged,
+	}}
+}
+
+func (l *jsonLogger) SetLevel(level logger.Level) {
+	l.Lock()
+	l.options.Level = level
+	l.Unlock()
+}
+
+func (l *jsonLogger) String() string {
+	return "json"
+}
+
+func (l *jsonLogger) write(level logger.Level, msg string) {
+	l.Lock()
+	defer l.Unlock()
+
+	if level < l.options.Level {
+		return
+	}
+
+	e := entry{
+		Time:   time.Now().Format(time.RFC3339),
+		Level:  level.String(),
+		Msg:    msg,
+		Fields: l.options.Fields,
+	}
+
+	enc := json.NewEncoder(l.options.Out)
+	enc.Encode(e)
+}
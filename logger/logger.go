@@ -0,0 +1,175 @@
+// Package logger is an interface for structured logging.
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log entry.
+type Level int
+
+const (
+	TraceLevel Level = iota
+	DebugLevel
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+	FatalLevel
+)
+
+var levelNames = map[Level]string{
+	TraceLevel: "trace",
+	DebugLevel: "debug",
+	InfoLevel:  "info",
+	WarnLevel:  "warn",
+	ErrorLevel: "error",
+	FatalLevel: "fatal",
+}
+
+// String returns the lowercase name of the level, e.g. "info".
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseLevel converts a level name, e.g. "info", into a Level. It
+// defaults to InfoLevel if name is not recognised.
+func ParseLevel(name string) Level {
+	for l, n := range levelNames {
+		if n == name {
+			return l
+		}
+	}
+	return InfoLevel
+}
+
+// Logger is a structured, levelled logger.
+type Logger interface {
+	// Init the logger
+	Init(opts ...Option) error
+	// Options returns the options used to configure the logger
+	Options() Options
+	// Log writes a message at the given level
+	Log(level Level, args ...interface{})
+	// Logf writes a formatted message at the given level
+	Logf(level Level, format string, args ...interface{})
+	// Fields returns a child Logger that always attaches fields
+	Fields(fields map[string]interface{}) Logger
+	// SetLevel changes the minimum level that will be logged
+	SetLevel(level Level)
+	// String returns the name of the implementation
+	String() string
+}
+
+// DefaultLogger is the logger used by the package-level helpers. It
+// is set by cmd.Cmd based on the --logger flag, and defaults to a
+// minimal stdout logger so Log/Logf/Fields never panic on a nil
+// logger before cmd has run. It is defined inline rather than in a
+// subpackage such as logger/stdout to avoid an import cycle.
+var DefaultLogger Logger = newDefaultLogger()
+
+// defaultLogger is a minimal text logger used only until cmd.Cmd
+// installs one selected by the --logger flag.
+type defaultLogger struct {
+	sync.Mutex
+	options Options
+}
+
+func newDefaultLogger(opts ...Option) Logger {
+	l := &defaultLogger{}
+	l.Init(opts...)
+	return l
+}
+
+func (l *defaultLogger) Init(opts ...Option) error {
+	for _, o := range opts {
+		o(&l.options)
+	}
+	if l.options.Out == nil {
+		l.options.Out = os.Stdout
+	}
+	return nil
+}
+
+func (l *defaultLogger) Options() Options {
+	return l.options
+}
+
+func (l *defaultLogger) Log(level Level, args ...interface{}) {
+	l.write(level, fmt.Sprint(args...))
+}
+
+func (l *defaultLogger) Logf(level Level, format string, args ...interface{}) {
+	l.write(level, fmt.Sprintf(format, args...))
+}
+
+func (l *defaultLogger) Fields(fields map[string]interface{}) Logger {
+	merged := make(map[string]interface{}, len(l.options.Fields)+len(fields))
+	for k, v := range l.options.Fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return &defaultLogger{options: Options{
+		Level:  l.options.Level,
+		Out:    l.options.Out,
+		Fields: merged,
+	}}
+}
+
+func (l *defaultLogger) SetLevel(level Level) {
+	l.Lock()
+	l.options.Level = level
+	l.Unlock()
+}
+
+func (l *defaultLogger) String() string {
+	return "default"
+}
+
+func (l *defaultLogger) write(level Level, msg string) {
+	l.Lock()
+	defer l.Unlock()
+
+	if level < l.options.Level {
+		return
+	}
+
+	line := fmt.Sprintf("%s level=%s msg=%q", time.Now().Format(time.RFC3339), level, msg)
+
+	keys := make([]string, 0, len(l.options.Fields))
+	for k := range l.options.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		line += fmt.Sprintf(" %s=%v", k, l.options.Fields[k])
+	}
+
+	fmt.Fprintln(l.options.Out, line)
+}
+
+// Log writes a message at the given level using DefaultLogger.
+func Log(level Level, args ...interface{}) {
+	DefaultLogger.Log(level, args...)
+}
+
+// Logf writes a formatted message at the given level using
+// DefaultLogger.
+func Logf(level Level, format string, args ...interface{}) {
+	DefaultLogger.Logf(level, format, args...)
+}
+
+// Fields returns a child of DefaultLogger that always attaches
+// fields.
+func Fields(fields map[string]interface{}) Logger {
+	return DefaultLogger.Fields(fields)
+}
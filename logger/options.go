@@ -0,0 +1,35 @@
+package logger
+
+import "io"
+
+type Options struct {
+	// Level is the minimum level that will be logged
+	Level Level
+	// Out is where log entries are written to
+	Out io.Writer
+	// Fields are attached to every entry written by the logger
+	Fields map[string]interface{}
+}
+
+type Option func(o *Options)
+
+// WithLevel sets the minimum level that will be logged.
+func WithLevel(level Level) Option {
+	return func(o *Options) {
+		o.Level = level
+	}
+}
+
+// WithOutput sets the writer log entries are written to.
+func WithOutput(out io.Writer) Option {
+	return func(o *Options) {
+		o.Out = out
+	}
+}
+
+// WithFields attaches fields to every entry written by the logger.
+func WithFields(fields map[string]interface{}) Option {
+	return func(o *Options) {
+		o.Fields = fields
+	}
+}
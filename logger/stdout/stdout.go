@@ -0,0 +1,101 @@
+// Package stdout is a text-formatted logger.Logger that writes to an
+// io.Writer, os.Stdout by default.
+package stdout
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/micro/go-micro/logger"
+)
+
+// NewLogger returns a text-formatted logger.
+func NewLogger(opts ...logger.Option) logger.Logger {
+	l := &stdoutLogger{}
+	l.Init(opts...)
+	return l
+}
+
+type stdoutLogger struct {
+	sync.Mutex
+	options logger.Options
+}
+
+func (l *stdoutLogger) Init(opts ...logger.Option) error {
+	for _, o := range opts {
+		o(&l.options)
+	}
+	if l.options.Out == nil {
+		l.options.Out = os.Stdout
+	}
+	return nil
+}
+
+func (l *stdoutLogger) Options() logger.Options {
+	return l.options
+}
+
+func (l *stdoutLogger) Log(level logger.Level, args ...interface{}) {
+	l.write(level, fmt.Sprint(args...))
+}
+
+func (l *stdoutLogger) Logf(level logger.Level, format string, args ...interface{}) {
+	l.write(level, fmt.Sprintf(format, args...))
+}
+
+func (l *stdoutLogger) Fields(fields map[string]interface{}) logger.Logger {
+	merged := make(map[string]interface{}, len(l.options.Fields)+len(fields))
+	for k, v := range l.options.Fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return &stdoutLogger{options: logger.Options{
+		Level:  l.options.Level,
+		Out:    l.options.Out,
+		Fields: merged,
+	}}
+}
+
+func (l *stdoutLogger) SetLevel(level logger.Level) {
+	l.Lock()
+	l.options.Level = level
+	l.Unlock()
+}
+
+func (l *stdoutLogger) String() string {
+	return "stdout"
+}
+
+func (l *stdoutLogger) write(level logger.Level, msg string) {
+	l.Lock()
+	defer l.Unlock()
+
+	if level < l.options.Level {
+		return
+	}
+
+	line := fmt.Sprintf("%s level=%s msg=%q", time.Now().Format(time.RFC3339), level, msg)
+
+	if len(l.options.Fields) > 0 {
+		keys := make([]string, 0, len(l.options.Fields))
+		for k := range l.options.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var fields []string
+		for _, k := range keys {
+			fields = append(fields, fmt.Sprintf("%s=%v", k, l.options.Fields[k]))
+		}
+		line += " " + strings.Join(fields, " ")
+	}
+
+	fmt.Fprintln(l.options.Out, line)
+}
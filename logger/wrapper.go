@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/server"
+)
+
+// NewHandlerWrapper logs an access entry, via l, for every request
+// the server handles.
+func NewHandlerWrapper(l Logger) server.HandlerWrapper {
+	return func(h server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			start := time.Now()
+			err := h(ctx, req, rsp)
+
+			fields := map[string]interface{}{
+				"service":  req.Service(),
+				"method":   req.Endpoint(),
+				"duration": time.Since(start).String(),
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			}
+
+			l.Fields(fields).Log(InfoLevel, "access")
+			return err
+		}
+	}
+}
+
+// NewClientWrapper logs an access entry, via l, for every request the
+// client makes.
+func NewClientWrapper(l Logger) client.Wrapper {
+	return func(c client.Client) client.Client {
+		return &clientWrapper{Client: c, logger: l}
+	}
+}
+
+type clientWrapper struct {
+	client.Client
+	logger Logger
+}
+
+func (c *clientWrapper) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	start := time.Now()
+	err := c.Client.Call(ctx, req, rsp, opts...)
+
+	fields := map[string]interface{}{
+		"service":  req.Service(),
+		"method":   req.Endpoint(),
+		"duration": time.Since(start).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	c.logger.Fields(fields).Log(InfoLevel, "access")
+	return err
+}
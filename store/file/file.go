@@ -0,0 +1,231 @@
+// Package file is a file system backed store.Store implementation,
+// backed by a bbolt database on disk.
+package file
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/bbolt"
+	"github.com/micro/go-micro/store"
+)
+
+// entry is the on-disk representation of a record, capturing its
+// metadata and expiry alongside the value so neither is lost to a
+// restart.
+type entry struct {
+	Record *store.Record
+	Expiry time.Time
+}
+
+// DefaultDir is used when no store.Database is given.
+const DefaultDir = "/tmp/micro/store"
+
+// NewStore returns a new file-backed store. The store.Database option
+// selects the bbolt file on disk (defaulting to DefaultDir/store.db)
+// and store.Table selects the bucket within it.
+func NewStore(opts ...store.Option) store.Store {
+	s := &fileStore{}
+	s.Init(opts...)
+	return s
+}
+
+type fileStore struct {
+	sync.Mutex
+
+	options store.Options
+	db      *bbolt.DB
+}
+
+func (f *fileStore) Init(opts ...store.Option) error {
+	for _, o := range opts {
+		o(&f.options)
+	}
+
+	dir := f.options.Database
+	if len(dir) == 0 {
+		dir = DefaultDir
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	db, err := bbolt.Open(filepath.Join(dir, "store.db"), 0600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+
+	f.Lock()
+	f.db = db
+	f.Unlock()
+
+	return nil
+}
+
+func (f *fileStore) Options() store.Options {
+	return f.options
+}
+
+func (f *fileStore) table() []byte {
+	table := f.options.Table
+	if len(table) == 0 {
+		table = "default"
+	}
+	return []byte(table)
+}
+
+func (f *fileStore) Read(key string, opts ...store.ReadOption) ([]*store.Record, error) {
+	options := store.NewReadOptions(opts...)
+
+	var records []*store.Record
+
+	err := f.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(f.table())
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			if !matches(string(k), key, options.Prefix, options.Suffix) {
+				return nil
+			}
+			e, err := decodeEntry(v)
+			if err != nil {
+				return err
+			}
+			if expired(e) {
+				return nil
+			}
+			if !e.Expiry.IsZero() {
+				e.Record.Expiry = time.Until(e.Expiry)
+			}
+			records = append(records, e.Record)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Offset > 0 && int(options.Offset) < len(records) {
+		records = records[options.Offset:]
+	}
+	if options.Limit > 0 && int(options.Limit) < len(records) {
+		records = records[:options.Limit]
+	}
+
+	return records, nil
+}
+
+func (f *fileStore) Write(r *store.Record, opts ...store.WriteOption) error {
+	options := store.NewWriteOptions(opts...)
+
+	e := &entry{Record: r}
+	if options.TTL > 0 {
+		e.Expiry = time.Now().Add(options.TTL)
+	}
+
+	data, err := encodeEntry(e)
+	if err != nil {
+		return err
+	}
+
+	return f.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(f.table())
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(r.Key), data)
+	})
+}
+
+func (f *fileStore) Delete(key string, opts ...store.DeleteOption) error {
+	return f.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(f.table())
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(key))
+	})
+}
+
+func (f *fileStore) List(opts ...store.ListOption) ([]string, error) {
+	options := store.NewListOptions(opts...)
+
+	var keys []string
+
+	err := f.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(f.table())
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			key := string(k)
+			if len(options.Prefix) > 0 && !strings.HasPrefix(key, options.Prefix) {
+				return nil
+			}
+			if len(options.Suffix) > 0 && !strings.HasSuffix(key, options.Suffix) {
+				return nil
+			}
+			e, err := decodeEntry(v)
+			if err != nil {
+				return err
+			}
+			if expired(e) {
+				return nil
+			}
+			keys = append(keys, key)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Offset > 0 && int(options.Offset) < len(keys) {
+		keys = keys[options.Offset:]
+	}
+	if options.Limit > 0 && int(options.Limit) < len(keys) {
+		keys = keys[:options.Limit]
+	}
+
+	return keys, nil
+}
+
+func (f *fileStore) String() string {
+	return "file"
+}
+
+// expired reports whether e's TTL has passed. A zero Expiry means the
+// record never expires.
+func expired(e *entry) bool {
+	return !e.Expiry.IsZero() && e.Expiry.Before(time.Now())
+}
+
+func encodeEntry(e *entry) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func decodeEntry(data []byte) (*entry, error) {
+	e := &entry{}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func matches(key, match string, prefix, suffix bool) bool {
+	switch {
+	case prefix:
+		return strings.HasPrefix(key, match)
+	case suffix:
+		return strings.HasSuffix(key, match)
+	default:
+		return key == match
+	}
+}
@@ -0,0 +1,154 @@
+// Package memory is an in-memory store.Store implementation, useful
+// for tests and single-instance deployments.
+package memory
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/micro/go-micro/store"
+)
+
+// NewStore returns a new in-memory store.
+func NewStore(opts ...store.Option) store.Store {
+	s := &memoryStore{
+		records: make(map[string]*entry),
+	}
+	s.Init(opts...)
+	return s
+}
+
+type entry struct {
+	record *store.Record
+	expiry time.Time
+}
+
+type memoryStore struct {
+	sync.RWMutex
+
+	options store.Options
+	records map[string]*entry
+}
+
+func (m *memoryStore) Init(opts ...store.Option) error {
+	for _, o := range opts {
+		o(&m.options)
+	}
+	return nil
+}
+
+func (m *memoryStore) Options() store.Options {
+	return m.options
+}
+
+func (m *memoryStore) Read(key string, opts ...store.ReadOption) ([]*store.Record, error) {
+	options := store.NewReadOptions(opts...)
+
+	m.Lock()
+	defer m.Unlock()
+
+	var records []*store.Record
+
+	for k, e := range m.records {
+		if !matches(k, key, options.Prefix, options.Suffix) {
+			continue
+		}
+		if m.expired(k, e) {
+			continue
+		}
+		rec := *e.record
+		if !e.expiry.IsZero() {
+			rec.Expiry = time.Until(e.expiry)
+		}
+		records = append(records, &rec)
+	}
+
+	if options.Offset > 0 && int(options.Offset) < len(records) {
+		records = records[options.Offset:]
+	}
+	if options.Limit > 0 && int(options.Limit) < len(records) {
+		records = records[:options.Limit]
+	}
+
+	return records, nil
+}
+
+func (m *memoryStore) Write(r *store.Record, opts ...store.WriteOption) error {
+	options := store.NewWriteOptions(opts...)
+
+	m.Lock()
+	defer m.Unlock()
+
+	e := &entry{record: r}
+	if options.TTL > 0 {
+		e.expiry = time.Now().Add(options.TTL)
+	}
+
+	m.records[r.Key] = e
+	return nil
+}
+
+func (m *memoryStore) Delete(key string, opts ...store.DeleteOption) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.records, key)
+	return nil
+}
+
+func (m *memoryStore) List(opts ...store.ListOption) ([]string, error) {
+	options := store.NewListOptions(opts...)
+
+	m.Lock()
+	defer m.Unlock()
+
+	var keys []string
+
+	for k, e := range m.records {
+		if len(options.Prefix) > 0 && !strings.HasPrefix(k, options.Prefix) {
+			continue
+		}
+		if len(options.Suffix) > 0 && !strings.HasSuffix(k, options.Suffix) {
+			continue
+		}
+		if m.expired(k, e) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+
+	if options.Offset > 0 && int(options.Offset) < len(keys) {
+		keys = keys[options.Offset:]
+	}
+	if options.Limit > 0 && int(options.Limit) < len(keys) {
+		keys = keys[:options.Limit]
+	}
+
+	return keys, nil
+}
+
+func (m *memoryStore) String() string {
+	return "memory"
+}
+
+// expired removes and reports records whose TTL has passed. Callers
+// must hold m's lock.
+func (m *memoryStore) expired(key string, e *entry) bool {
+	if e.expiry.IsZero() || e.expiry.After(time.Now()) {
+		return false
+	}
+	delete(m.records, key)
+	return true
+}
+
+func matches(key, match string, prefix, suffix bool) bool {
+	switch {
+	case prefix:
+		return strings.HasPrefix(key, match)
+	case suffix:
+		return strings.HasSuffix(key, match)
+	default:
+		return key == match
+	}
+}
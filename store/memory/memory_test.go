@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/store"
+)
+
+func TestReadWrite(t *testing.T) {
+	s := NewStore()
+
+	if err := s.Write(&store.Record{Key: "foo", Value: []byte("bar")}); err != nil {
+		t.Fatalf("unexpected error writing record: %v", err)
+	}
+
+	recs, err := s.Read("foo")
+	if err != nil {
+		t.Fatalf("unexpected error reading record: %v", err)
+	}
+	if len(recs) != 1 || string(recs[0].Value) != "bar" {
+		t.Fatalf("expected to read back the written record, got %+v", recs)
+	}
+}
+
+func TestReadPrefixSuffix(t *testing.T) {
+	s := NewStore()
+
+	s.Write(&store.Record{Key: "foo.bar"})
+	s.Write(&store.Record{Key: "foo.baz"})
+	s.Write(&store.Record{Key: "bar.foo"})
+
+	recs, err := s.Read("foo.", store.ReadPrefix())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 records with prefix foo., got %d", len(recs))
+	}
+
+	recs, err = s.Read(".foo", store.ReadSuffix())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recs) != 1 {
+		t.Fatalf("expected 1 record with suffix .foo, got %d", len(recs))
+	}
+}
+
+func TestListLimitOffset(t *testing.T) {
+	s := NewStore()
+
+	for _, k := range []string{"a", "b", "c"} {
+		s.Write(&store.Record{Key: k})
+	}
+
+	keys, err := s.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 keys, got %d", len(keys))
+	}
+
+	keys, err = s.List(store.ListOffset(1), store.ListLimit(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("expected 1 key after offset and limit, got %d", len(keys))
+	}
+}
+
+func TestWriteTTLExpires(t *testing.T) {
+	s := NewStore()
+
+	if err := s.Write(&store.Record{Key: "foo"}, store.WriteTTL(time.Millisecond)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	recs, err := s.Read("foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recs) != 0 {
+		t.Fatalf("expected expired record to be filtered out, got %+v", recs)
+	}
+
+	keys, err := s.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 0 {
+		t.Fatalf("expected expired record to be removed from the key listing, got %+v", keys)
+	}
+}
+
+func TestWriteNoTTL(t *testing.T) {
+	s := NewStore()
+
+	if err := s.Write(&store.Record{Key: "foo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recs, err := s.Read("foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recs) != 1 {
+		t.Fatalf("expected record with no TTL to never expire, got %+v", recs)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := NewStore()
+
+	s.Write(&store.Record{Key: "foo"})
+	if err := s.Delete("foo"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recs, err := s.Read("foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recs) != 0 {
+		t.Fatalf("expected record to be deleted, got %+v", recs)
+	}
+}
@@ -0,0 +1,175 @@
+package store
+
+import "time"
+
+type Options struct {
+	// Nodes is a list of addresses to use for the backing store.
+	Nodes []string
+	// Database, namespace or bucket to read/write from.
+	Database string
+	// Table, partition or prefix within the Database.
+	Table string
+}
+
+type Option func(o *Options)
+
+// Nodes sets the addresses of the backing store.
+func Nodes(addrs ...string) Option {
+	return func(o *Options) {
+		o.Nodes = addrs
+	}
+}
+
+// Database sets the database, namespace or bucket used to isolate
+// records between services.
+func Database(db string) Option {
+	return func(o *Options) {
+		o.Database = db
+	}
+}
+
+// Table sets the table, partition or prefix to scope records within
+// a Database.
+func Table(table string) Option {
+	return func(o *Options) {
+		o.Table = table
+	}
+}
+
+// ReadOptions adjust the behaviour of Read.
+type ReadOptions struct {
+	// Prefix matches keys that have Key as a prefix
+	Prefix bool
+	// Suffix matches keys that have Key as a suffix
+	Suffix bool
+	// Limit the number of records returned
+	Limit uint
+	// Offset the records returned by
+	Offset uint
+}
+
+type ReadOption func(o *ReadOptions)
+
+// ReadPrefix matches keys that have Key as a prefix.
+func ReadPrefix() ReadOption {
+	return func(o *ReadOptions) {
+		o.Prefix = true
+	}
+}
+
+// ReadSuffix matches keys that have Key as a suffix.
+func ReadSuffix() ReadOption {
+	return func(o *ReadOptions) {
+		o.Suffix = true
+	}
+}
+
+// ReadLimit limits the number of records returned.
+func ReadLimit(limit uint) ReadOption {
+	return func(o *ReadOptions) {
+		o.Limit = limit
+	}
+}
+
+// ReadOffset skips a number of records before returning results.
+func ReadOffset(offset uint) ReadOption {
+	return func(o *ReadOptions) {
+		o.Offset = offset
+	}
+}
+
+func NewReadOptions(opts ...ReadOption) ReadOptions {
+	var options ReadOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// WriteOptions adjust the behaviour of Write.
+type WriteOptions struct {
+	// TTL is the duration the record should be kept for before it
+	// expires. Zero means the record never expires.
+	TTL time.Duration
+}
+
+type WriteOption func(o *WriteOptions)
+
+// WriteTTL sets the duration the record should be kept for before it
+// expires.
+func WriteTTL(ttl time.Duration) WriteOption {
+	return func(o *WriteOptions) {
+		o.TTL = ttl
+	}
+}
+
+func NewWriteOptions(opts ...WriteOption) WriteOptions {
+	var options WriteOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// DeleteOptions adjust the behaviour of Delete.
+type DeleteOptions struct{}
+
+type DeleteOption func(o *DeleteOptions)
+
+func NewDeleteOptions(opts ...DeleteOption) DeleteOptions {
+	var options DeleteOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// ListOptions adjust the behaviour of List.
+type ListOptions struct {
+	// Prefix matches keys that have Prefix as a prefix
+	Prefix string
+	// Suffix matches keys that have Suffix as a suffix
+	Suffix string
+	// Limit the number of keys returned
+	Limit uint
+	// Offset the keys returned by
+	Offset uint
+}
+
+type ListOption func(o *ListOptions)
+
+// ListPrefix matches keys that have p as a prefix.
+func ListPrefix(p string) ListOption {
+	return func(o *ListOptions) {
+		o.Prefix = p
+	}
+}
+
+// ListSuffix matches keys that have s as a suffix.
+func ListSuffix(s string) ListOption {
+	return func(o *ListOptions) {
+		o.Suffix = s
+	}
+}
+
+// ListLimit limits the number of keys returned.
+func ListLimit(limit uint) ListOption {
+	return func(o *ListOptions) {
+		o.Limit = limit
+	}
+}
+
+// ListOffset skips a number of keys before returning results.
+func ListOffset(offset uint) ListOption {
+	return func(o *ListOptions) {
+		o.Offset = offset
+	}
+}
+
+func NewListOptions(opts ...ListOption) ListOptions {
+	var options ListOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
@@ -0,0 +1,45 @@
+// Package store is an interface for key-value data storage.
+package store
+
+import "time"
+
+// Store is a data storage interface. It provides a simple way of
+// reading, writing and deleting keyed records, scoped to a database
+// and table, regardless of the underlying storage implementation.
+type Store interface {
+	// Init initialises the store
+	Init(opts ...Option) error
+	// Options returns the options used to configure the store
+	Options() Options
+	// Read takes a single key name and optional ReadOptions. It
+	// returns matching []*Record or an error.
+	Read(key string, opts ...ReadOption) ([]*Record, error)
+	// Write writes a record to the store, and returns an error if
+	// the record was not written.
+	Write(r *Record, opts ...WriteOption) error
+	// Delete removes the record with the corresponding key from the
+	// store.
+	Delete(key string, opts ...DeleteOption) error
+	// List returns any keys that match, or an empty list with no
+	// error if none matched.
+	List(opts ...ListOption) ([]string, error)
+	// String returns the name of the implementation.
+	String() string
+}
+
+// Record is an item stored or retrieved from a Store.
+type Record struct {
+	// Key of the record
+	Key string
+	// Value of the record
+	Value []byte
+	// Metadata associated with the record
+	Metadata map[string]interface{}
+	// Expiry is the time the record has left to live. Zero means
+	// the record has no expiry.
+	Expiry time.Duration
+}
+
+// DefaultStore is the store used by default within a service. It is
+// set by cmd.Cmd based on the --store flag.
+var DefaultStore Store